@@ -0,0 +1,145 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DriftTargetRef selects the resource(s) a DriftPolicy watches, either a
+// single named object or every object matching Selector.
+type DriftTargetRef struct {
+	// Group is the API group of the target, empty for the core group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Version is the API version of the target, e.g. "v1".
+	Version string `json:"version"`
+	// Kind is the target's Kind, e.g. "Ingress", "StatefulSet", "ConfigMap".
+	Kind string `json:"kind"`
+
+	// Name targets a single object. Mutually exclusive with Selector.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Selector targets every object matching these labels. Mutually
+	// exclusive with Name.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// ExpectedHashSourceType selects where a DriftPolicy's expected hash comes from.
+type ExpectedHashSourceType string
+
+const (
+	ExpectedHashInline    ExpectedHashSourceType = "Inline"
+	ExpectedHashConfigMap ExpectedHashSourceType = "ConfigMap"
+	ExpectedHashSecret    ExpectedHashSourceType = "Secret"
+)
+
+// ExpectedHashSource describes where to read the expected hash a policy
+// compares its projected targets against.
+type ExpectedHashSource struct {
+	// +kubebuilder:validation:Enum=Inline;ConfigMap;Secret
+	Type ExpectedHashSourceType `json:"type"`
+
+	// Inline is used when Type is Inline.
+	// +optional
+	Inline string `json:"inline,omitempty"`
+	// ConfigMapRef is used when Type is ConfigMap.
+	// +optional
+	ConfigMapRef *corev1.LocalObjectReference `json:"configMapRef,omitempty"`
+	// SecretRef is used when Type is Secret.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	// Key is the ConfigMap/Secret key holding the expected hash. Defaults to "hash".
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// DriftPolicySpec defines the desired state of DriftPolicy.
+type DriftPolicySpec struct {
+	// TargetRef selects the resource(s) this policy watches for drift.
+	TargetRef DriftTargetRef `json:"targetRef"`
+
+	// FieldPaths lists the dot-separated fields (e.g. "spec.replicas",
+	// "spec.template.spec.containers") projected out of the target and
+	// included in its fingerprint.
+	FieldPaths []string `json:"fieldPaths"`
+
+	// IgnorePaths drops any FieldPaths entry it names exactly. A field
+	// nested under a FieldPaths entry is not removed from that entry's value.
+	// +optional
+	IgnorePaths []string `json:"ignorePaths,omitempty"`
+
+	// ExpectedHashFrom is the baseline each target's projected fingerprint
+	// hash is compared against.
+	ExpectedHashFrom ExpectedHashSource `json:"expectedHashFrom"`
+
+	// PollInterval controls how often targets are re-fetched and
+	// re-projected. Defaults to 5m.
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+}
+
+// DriftPolicyStatus defines the observed state of DriftPolicy.
+type DriftPolicyStatus struct {
+	// ObservedTargets is the number of objects matched by TargetRef during
+	// the last reconcile.
+	// +optional
+	ObservedTargets int `json:"observedTargets,omitempty"`
+
+	// DriftedTargets names (namespace/name) every currently-drifted target.
+	// +optional
+	DriftedTargets []string `json:"driftedTargets,omitempty"`
+
+	// +optional
+	LastCheckedAt *metav1.Time `json:"lastCheckedAt,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+
+// DriftPolicy generalizes drift detection to arbitrary resource kinds: it
+// projects the fields named in Spec.FieldPaths out of every object matched
+// by Spec.TargetRef and compares their hash against Spec.ExpectedHashFrom,
+// the same way the hard-coded Deployment/Service reconcilers compare
+// drift.AnnExpectedHash - but without needing a Go type or a dedicated
+// reconciler for every Kind operators want to track.
+type DriftPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DriftPolicySpec   `json:"spec,omitempty"`
+	Status DriftPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DriftPolicyList contains a list of DriftPolicy.
+type DriftPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DriftPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DriftPolicy{}, &DriftPolicyList{})
+}
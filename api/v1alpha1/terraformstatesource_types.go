@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TerraformStateSourceType identifies where the terraform.tfstate JSON document
+// should be read from.
+type TerraformStateSourceType string
+
+const (
+	TerraformStateSourceConfigMap TerraformStateSourceType = "ConfigMap"
+	TerraformStateSourceSecret    TerraformStateSourceType = "Secret"
+	TerraformStateSourceHTTP      TerraformStateSourceType = "HTTP"
+)
+
+// TerraformStateSourceRef describes where to fetch the terraform.tfstate JSON from.
+//
+// S3/GCS/Azure backends are not supported yet - pulling in their SDKs is
+// follow-up work - so this only covers the backends tfstate.Fetch can
+// actually read. Mirror state pushed to one of those object stores into a
+// ConfigMap/Secret (e.g. from the same CI job that runs `terraform apply`)
+// until then.
+type TerraformStateSourceRef struct {
+	// Type selects which of the fields below is populated.
+	// +kubebuilder:validation:Enum=ConfigMap;Secret;HTTP
+	Type TerraformStateSourceType `json:"type"`
+
+	// ConfigMapRef is used when Type is ConfigMap.
+	// +optional
+	ConfigMapRef *corev1.LocalObjectReference `json:"configMapRef,omitempty"`
+	// SecretRef is used when Type is Secret.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	// Key is the key within the ConfigMap/Secret holding the tfstate JSON document.
+	// Defaults to "terraform.tfstate".
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// URL is used when Type is HTTP, and is fetched with a plain GET.
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// TerraformStateSourceSpec defines the desired state of TerraformStateSource.
+type TerraformStateSourceSpec struct {
+	// SourceRef points at the backend holding the terraform.tfstate JSON document.
+	SourceRef TerraformStateSourceRef `json:"sourceRef"`
+
+	// PollInterval controls how often the state file is re-fetched and
+	// re-projected onto target resources. Defaults to 5m.
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+}
+
+// TerraformStateSourceStatus defines the observed state of TerraformStateSource.
+type TerraformStateSourceStatus struct {
+	// LastSyncedAt is the last time the state file was successfully read and projected.
+	// +optional
+	LastSyncedAt *metav1.Time `json:"lastSyncedAt,omitempty"`
+
+	// TerraformVersion is the `terraform_version` recorded in the last synced state file.
+	// +optional
+	TerraformVersion string `json:"terraformVersion,omitempty"`
+
+	// Serial is the `serial` recorded in the last synced state file.
+	// +optional
+	Serial int64 `json:"serial,omitempty"`
+
+	// ObservedResources is the number of managed resources for which an
+	// expected hash was computed during the last sync.
+	// +optional
+	ObservedResources int `json:"observedResources,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+
+// TerraformStateSource lets operators point the tfdrift-operator at a
+// terraform.tfstate document so that drift.AnnExpectedHash can be populated
+// automatically instead of being set by hand on every resource.
+type TerraformStateSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TerraformStateSourceSpec   `json:"spec,omitempty"`
+	Status TerraformStateSourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TerraformStateSourceList contains a list of TerraformStateSource.
+type TerraformStateSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TerraformStateSource `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TerraformStateSource{}, &TerraformStateSourceList{})
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command manager runs the tfdrift-operator controllers: DeploymentReconciler,
+// ServiceReconciler, PolicyReconciler and TerraformStateReconciler.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	tfdriftv1alpha1 "github.com/Bichong-Jin/tfdrift-operator/api/v1alpha1"
+	"github.com/Bichong-Jin/tfdrift-operator/internal/controller"
+	"github.com/Bichong-Jin/tfdrift-operator/internal/drift"
+)
+
+var (
+	scheme   = runtime.NewScheme()
+	setupLog = ctrl.Log.WithName("setup")
+)
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = tfdriftv1alpha1.AddToScheme(scheme)
+}
+
+func main() {
+	var metricsAddr string
+	var probeAddr string
+	var enableLeaderElection bool
+	var remediationModeFlag string
+	var trustedKeysSecret string
+	var trustedKeysNamespace string
+	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metrics endpoint binds to.")
+	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the health probe endpoint binds to.")
+	flag.BoolVar(&enableLeaderElection, "leader-elect", false, "Enable leader election so only one operator replica is active at a time.")
+	flag.StringVar(&remediationModeFlag, "remediation-mode", "off", "Auto-remediation mode: off, dry-run or enforce. See controller.ParseRemediationMode.")
+	flag.StringVar(&trustedKeysSecret, "trusted-keys-secret", "", "Name of a Secret holding PEM-encoded public keys that sign AnnExpectedHash/ExpectedHashFrom. Leaving this unset disables signature verification.")
+	flag.StringVar(&trustedKeysNamespace, "trusted-keys-namespace", "", "Namespace of --trusted-keys-secret. Required when --trusted-keys-secret is set.")
+	opts := zap.Options{Development: true}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+
+	remediationMode, err := controller.ParseRemediationMode(remediationModeFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid --remediation-mode")
+		os.Exit(1)
+	}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Scheme:                 scheme,
+		MetricsBindAddress:     metricsAddr,
+		HealthProbeBindAddress: probeAddr,
+		LeaderElection:         enableLeaderElection,
+		LeaderElectionID:       "tfdrift-operator-leader-election",
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to start manager")
+		os.Exit(1)
+	}
+
+	var verifier *drift.Verifier
+	if trustedKeysSecret != "" {
+		if trustedKeysNamespace == "" {
+			setupLog.Error(nil, "--trusted-keys-namespace is required when --trusted-keys-secret is set")
+			os.Exit(1)
+		}
+		// The manager's cache isn't started yet, so read the secret with a
+		// direct, uncached client instead of mgr.GetClient().
+		apiReader, err := client.New(mgr.GetConfig(), client.Options{Scheme: scheme})
+		if err != nil {
+			setupLog.Error(err, "unable to build client for --trusted-keys-secret")
+			os.Exit(1)
+		}
+		verifier, err = controller.LoadTrustedKeysVerifier(context.Background(), apiReader, trustedKeysNamespace, trustedKeysSecret)
+		if err != nil {
+			setupLog.Error(err, "unable to load --trusted-keys-secret")
+			os.Exit(1)
+		}
+	}
+
+	if err := (&controller.DeploymentReconciler{
+		Client:          mgr.GetClient(),
+		Log:             ctrl.Log.WithName("controllers").WithName("Deployment"),
+		RemediationMode: remediationMode,
+		Verifier:        verifier,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Deployment")
+		os.Exit(1)
+	}
+
+	if err := (&controller.ServiceReconciler{
+		Client:          mgr.GetClient(),
+		Log:             ctrl.Log.WithName("controllers").WithName("Service"),
+		RemediationMode: remediationMode,
+		Verifier:        verifier,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Service")
+		os.Exit(1)
+	}
+
+	if err := (&controller.PolicyReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("DriftPolicy"),
+		Verifier: verifier,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DriftPolicy")
+		os.Exit(1)
+	}
+
+	if err := (&controller.TerraformStateReconciler{
+		Client: mgr.GetClient(),
+		Log:    ctrl.Log.WithName("controllers").WithName("TerraformStateSource"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TerraformStateSource")
+		os.Exit(1)
+	}
+
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up health check")
+		os.Exit(1)
+	}
+	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up ready check")
+		os.Exit(1)
+	}
+
+	setupLog.Info("starting manager", "remediationMode", remediationMode, "signatureVerification", verifier != nil)
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		setupLog.Error(err, "problem running manager")
+		os.Exit(1)
+	}
+}
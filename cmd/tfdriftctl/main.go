@@ -0,0 +1,137 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command tfdriftctl is a companion CLI for signing expected-state
+// attestations that the operator's reconcilers verify before trusting
+// drift.AnnExpectedHash.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/Bichong-Jin/tfdrift-operator/internal/drift"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "sign":
+		err = runSign(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "tfdriftctl:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: tfdriftctl sign --kind=deployment|service --manifest=FILE --key=FILE")
+}
+
+// runSign computes the fingerprint hash of the expected manifest at
+// --manifest, signs it with the private key at --key, and prints the
+// "<hash>.<base64-signature>" value to store in drift.AnnExpectedHash.
+func runSign(args []string) error {
+	fs := flag.NewFlagSet("sign", flag.ContinueOnError)
+	kind := fs.String("kind", "", "resource kind of the manifest: deployment or service")
+	manifestPath := fs.String("manifest", "", "path to the JSON-encoded expected manifest")
+	keyPath := fs.String("key", "", "path to a PEM-encoded PKCS#8 Ed25519 or ECDSA P-256 private key")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *kind == "" || *manifestPath == "" || *keyPath == "" {
+		return fmt.Errorf("--kind, --manifest, and --key are required")
+	}
+
+	manifest, err := os.ReadFile(*manifestPath)
+	if err != nil {
+		return fmt.Errorf("read manifest: %w", err)
+	}
+
+	hash, subject, err := hashManifest(*kind, manifest)
+	if err != nil {
+		return err
+	}
+
+	keyPEM, err := os.ReadFile(*keyPath)
+	if err != nil {
+		return fmt.Errorf("read key: %w", err)
+	}
+	key, err := drift.ParsePrivateKey(keyPEM)
+	if err != nil {
+		return err
+	}
+
+	annotation, err := drift.Sign(key, subject, hash)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(annotation)
+	return nil
+}
+
+// hashManifest fingerprints and hashes manifest the same way the
+// DeploymentReconciler/ServiceReconciler hash the live object, so a signed
+// hash produced here compares equal once the manifest is applied. It also
+// returns subject ("namespace/name"), which the signature is bound to so it
+// can't be replayed onto a different object - manifest.metadata.namespace
+// and .name must both be set.
+//
+// manifest must be JSON, not YAML - convert with e.g. `kubectl -o json` or
+// `yq -o=json` first.
+func hashManifest(kind string, manifest []byte) (hash, subject string, err error) {
+	switch strings.ToLower(kind) {
+	case "deployment":
+		var dep appsv1.Deployment
+		if err := json.Unmarshal(manifest, &dep); err != nil {
+			return "", "", fmt.Errorf("parse manifest as Deployment: %w", err)
+		}
+		if dep.Namespace == "" || dep.Name == "" {
+			return "", "", fmt.Errorf("manifest metadata.namespace and metadata.name are required")
+		}
+		hash, err := drift.HashDeployment(&dep)
+		return hash, dep.Namespace + "/" + dep.Name, err
+	case "service":
+		var svc corev1.Service
+		if err := json.Unmarshal(manifest, &svc); err != nil {
+			return "", "", fmt.Errorf("parse manifest as Service: %w", err)
+		}
+		if svc.Namespace == "" || svc.Name == "" {
+			return "", "", fmt.Errorf("manifest metadata.namespace and metadata.name are required")
+		}
+		hash, err := drift.HashService(&svc)
+		return hash, svc.Namespace + "/" + svc.Name, err
+	default:
+		return "", "", fmt.Errorf("unsupported --kind %q (want deployment or service)", kind)
+	}
+}
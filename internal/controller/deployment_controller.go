@@ -18,36 +18,74 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
 	"k8s.io/client-go/tools/record"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/<you>/tfdrift-operator/internal/drift"
+	"github.com/Bichong-Jin/tfdrift-operator/internal/drift"
+	"github.com/Bichong-Jin/tfdrift-operator/internal/metrics"
 )
 
+const deploymentKind = "Deployment"
+
 type DeploymentReconciler struct {
 	client.Client
 	Log      logr.Logger
 	Recorder record.EventRecorder
+
+	// RemediationMode gates whether drifted Deployments are patched back to
+	// their expected spec. Defaults to RemediationOff.
+	RemediationMode RemediationMode
+	// IgnoreFields lists dotted spec paths ("spec.replicas", "spec.strategy",
+	// "spec.template") to leave untouched during remediation. When nil, it is
+	// computed per-Deployment: "spec.replicas" is ignored automatically when
+	// an HPA targets the Deployment.
+	IgnoreFields []string
+
+	// Verifier checks that AnnExpectedHash is a valid detached signature
+	// over the baseline hash before trusting it, so editing the annotation
+	// directly can't hide drift. Populated from --trusted-keys-secret when
+	// set; nil disables signature verification and AnnExpectedHash is
+	// compared as a plain hash, as before.
+	Verifier *drift.Verifier
+}
+
+// expectedDeploymentSpec is the subset of a Deployment spec an
+// AnnExpectedSpecRef ConfigMap may declare (JSON-encoded under the "spec" key).
+type expectedDeploymentSpec struct {
+	Replicas *int32                     `json:"replicas,omitempty"`
+	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+	Template *corev1.PodTemplateSpec    `json:"template,omitempty"`
 }
 
 // RBAC (kubebuilder markers)
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 func (r *DeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.Log.WithValues("deployment", req.NamespacedName)
 
+	start := time.Now()
+	defer func() {
+		metrics.ReconcileDuration.WithLabelValues(deploymentKind).Observe(time.Since(start).Seconds())
+	}()
+
 	var dep appsv1.Deployment
 	if err := r.Get(ctx, req.NamespacedName, &dep); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -72,6 +110,20 @@ func (r *DeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		})
 	}
 
+	if r.Verifier != nil {
+		verifiedHash, err := r.Verifier.Verify(expected, dep.Namespace+"/"+dep.Name)
+		if err != nil {
+			r.Recorder.Eventf(&dep, corev1.EventTypeWarning, "TerraformExpectedHashUntrusted",
+				"Expected hash annotation rejected: %s", err)
+			log.Info("rejecting untrusted expected hash", "error", err.Error())
+			return r.patchAnnotations(ctx, &dep, map[string]string{
+				drift.AnnExpectedHashValid: "false",
+				drift.AnnLastCheckedAt:     time.Now().UTC().Format(time.RFC3339),
+			})
+		}
+		expected = verifiedHash
+	}
+
 	liveHash, err := drift.HashDeployment(&dep)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -83,6 +135,9 @@ func (r *DeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		drift.AnnLiveHash:      liveHash,
 		drift.AnnLastCheckedAt: now,
 	}
+	if r.Verifier != nil {
+		patch[drift.AnnExpectedHashValid] = "true"
+	}
 
 	drifted := (liveHash != expected)
 	if drifted {
@@ -91,14 +146,197 @@ func (r *DeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			patch[drift.AnnDriftedAt] = now
 		}
 
+		diffJSON := ""
+		if diffs := r.driftDiffs(ctx, &dep); len(diffs) > 0 {
+			if blob, err := drift.TopFieldDiffsJSON(diffs, maxDriftDetailDiffs); err == nil {
+				patch[drift.AnnDriftDetails] = blob
+				diffJSON = blob
+			}
+		}
+
+		metrics.DriftDetectedTotal.WithLabelValues(deploymentKind).Inc()
 		r.Recorder.Eventf(&dep, corev1.EventTypeWarning, "TerraformDriftDetected",
-			"Deployment drift detected: expectedHash=%s liveHash=%s", expected, liveHash)
+			"Deployment drift detected: expectedHash=%s liveHash=%s%s", expected, liveHash, eventDiffSuffix(diffJSON))
 		log.Info("drift detected", "expected", expected, "live", liveHash)
 	} else {
 		patch[drift.AnnDrifted] = "false"
 	}
 
-	return r.patchAnnotations(ctx, &dep, patch)
+	metrics.ResourceDrifted.WithLabelValues(deploymentKind, dep.Namespace, dep.Name).Set(boolToFloat(drifted))
+	metrics.LastCheckTimestamp.WithLabelValues(deploymentKind, dep.Namespace, dep.Name).SetToCurrentTime()
+
+	if _, err := r.patchAnnotations(ctx, &dep, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+	if !drifted {
+		return ctrl.Result{}, nil
+	}
+
+	return r.maybeRemediate(ctx, log, &dep)
+}
+
+// driftDiffs computes field-level diffs between the live Deployment and the
+// "expected spec" ConfigMap named by AnnExpectedSpecRef, if one is set. It
+// returns nil (not an error) when no expected-spec-ref is configured, since
+// AnnExpectedHash alone doesn't carry enough information to diff fields.
+func (r *DeploymentReconciler) driftDiffs(ctx context.Context, dep *appsv1.Deployment) []drift.FieldDiff {
+	specRefName := dep.Annotations[drift.AnnExpectedSpecRef]
+	if specRefName == "" {
+		return nil
+	}
+
+	var cm corev1.ConfigMap
+	if err := r.Get(ctx, types.NamespacedName{Namespace: dep.Namespace, Name: specRefName}, &cm); err != nil {
+		return nil
+	}
+
+	var expected expectedDeploymentSpec
+	if err := json.Unmarshal([]byte(cm.Data["spec"]), &expected); err != nil {
+		return nil
+	}
+
+	liveFP := drift.FingerprintDeployment(dep)
+	expectedFP := liveFP
+	if expected.Replicas != nil {
+		expectedFP.Replicas = expected.Replicas
+	}
+	if expected.Strategy != nil {
+		expectedFP.Strategy = *expected.Strategy
+	}
+	if expected.Template != nil {
+		expectedFP.Template = drift.FingerprintDeployment(&appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Template: *expected.Template},
+		}).Template
+	}
+
+	return drift.Diff(expectedFP, liveFP)
+}
+
+// maybeRemediate reverts a drifted Deployment's fingerprinted fields back to
+// the "expected spec" ConfigMap named by AnnExpectedSpecRef, when the
+// tfdrift.jin.dev/remediate=true label is set and RemediationMode allows it.
+// It honors AnnRemediationCooldownUntil so it doesn't fight other
+// controllers (e.g. an HPA resetting spec.replicas) every reconcile.
+func (r *DeploymentReconciler) maybeRemediate(ctx context.Context, log logr.Logger, dep *appsv1.Deployment) (ctrl.Result, error) {
+	if r.RemediationMode == RemediationOff || dep.Labels[drift.LabelRemediate] != "true" {
+		return ctrl.Result{}, nil
+	}
+
+	if until, ok := dep.Annotations[drift.AnnRemediationCooldownUntil]; ok {
+		if t, err := time.Parse(time.RFC3339, until); err == nil {
+			if remaining := time.Until(t); remaining > 0 {
+				return ctrl.Result{RequeueAfter: remaining}, nil
+			}
+		}
+	}
+
+	specRefName := dep.Annotations[drift.AnnExpectedSpecRef]
+	if specRefName == "" {
+		log.Info("remediation enabled but no expected-spec-ref annotation set")
+		return ctrl.Result{}, nil
+	}
+
+	var cm corev1.ConfigMap
+	if err := r.Get(ctx, types.NamespacedName{Namespace: dep.Namespace, Name: specRefName}, &cm); err != nil {
+		log.Error(err, "failed to get expected-spec ConfigMap", "configMap", specRefName)
+		return ctrl.Result{}, nil
+	}
+
+	var expected expectedDeploymentSpec
+	if err := json.Unmarshal([]byte(cm.Data["spec"]), &expected); err != nil {
+		log.Error(err, "failed to parse expected spec", "configMap", specRefName)
+		return ctrl.Result{}, nil
+	}
+
+	ignoreFields := r.IgnoreFields
+	if ignoreFields == nil {
+		ignoreFields = r.defaultIgnoreFields(ctx, dep)
+	}
+
+	patchBytes, err := computeDeploymentPatch(dep, expected, ignoreFields)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if patchBytes == nil {
+		return ctrl.Result{}, nil
+	}
+
+	attempts := remediationAttempts(dep.Annotations)
+	backoff := remediationBackoff(attempts)
+
+	switch r.RemediationMode {
+	case RemediationEnforce:
+		if err := r.Patch(ctx, dep, client.RawPatch(types.StrategicMergePatchType, patchBytes)); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(dep, corev1.EventTypeNormal, "TerraformDriftRemediated",
+			"Reverted drifted fields to expected spec: %s", truncatePatch(patchBytes))
+		log.Info("remediated drift", "patch", string(patchBytes))
+	case RemediationDryRun:
+		r.Recorder.Eventf(dep, corev1.EventTypeNormal, "TerraformDriftWouldRemediate",
+			"Would revert drifted fields (dry-run): %s", truncatePatch(patchBytes))
+		log.Info("would remediate drift (dry-run)", "patch", string(patchBytes))
+	}
+
+	if _, err := r.patchAnnotations(ctx, dep, map[string]string{
+		drift.AnnRemediationCooldownUntil: time.Now().UTC().Add(backoff).Format(time.RFC3339),
+		drift.AnnRemediationAttempts:      strconv.Itoa(attempts + 1),
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: backoff}, nil
+}
+
+// defaultIgnoreFields ignores spec.replicas automatically when an HPA
+// targets dep, so remediation doesn't perpetually fight it back to the
+// terraform-declared replica count.
+func (r *DeploymentReconciler) defaultIgnoreFields(ctx context.Context, dep *appsv1.Deployment) []string {
+	var hpas autoscalingv2.HorizontalPodAutoscalerList
+	if err := r.List(ctx, &hpas, client.InNamespace(dep.Namespace)); err != nil {
+		return nil
+	}
+	for _, hpa := range hpas.Items {
+		if hpa.Spec.ScaleTargetRef.Kind == "Deployment" && hpa.Spec.ScaleTargetRef.Name == dep.Name {
+			return []string{"spec.replicas"}
+		}
+	}
+	return nil
+}
+
+// computeDeploymentPatch builds the strategic merge patch that reverts dep's
+// fingerprinted fields to expected, skipping any field named in ignoreFields.
+// It returns a nil patch when there is nothing to revert.
+func computeDeploymentPatch(dep *appsv1.Deployment, expected expectedDeploymentSpec, ignoreFields []string) ([]byte, error) {
+	originalJSON, err := json.Marshal(dep)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := dep.DeepCopy()
+	if expected.Replicas != nil && !fieldIgnored(ignoreFields, "spec.replicas") {
+		desired.Spec.Replicas = expected.Replicas
+	}
+	if expected.Strategy != nil && !fieldIgnored(ignoreFields, "spec.strategy") {
+		desired.Spec.Strategy = *expected.Strategy
+	}
+	if expected.Template != nil && !fieldIgnored(ignoreFields, "spec.template") {
+		desired.Spec.Template = *expected.Template
+	}
+
+	desiredJSON, err := json.Marshal(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	patchBytes, err := strategicpatch.CreateTwoWayMergePatch(originalJSON, desiredJSON, appsv1.Deployment{})
+	if err != nil {
+		return nil, err
+	}
+	if string(patchBytes) == "{}" {
+		return nil, nil
+	}
+	return patchBytes, nil
 }
 
 func (r *DeploymentReconciler) patchAnnotations(ctx context.Context, dep *appsv1.Deployment, kv map[string]string) (ctrl.Result, error) {
@@ -123,4 +361,3 @@ func (r *DeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&appsv1.Deployment{}).
 		Complete(r)
 }
-
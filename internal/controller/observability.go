@@ -0,0 +1,41 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import "fmt"
+
+// maxDriftDetailDiffs caps how many FieldDiff entries are kept in the
+// AnnDriftDetails annotation and event message; a Deployment with a fully
+// replaced PodSpec could otherwise produce an unbounded number of diffs.
+const maxDriftDetailDiffs = 5
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// eventDiffSuffix renders diffJSON (already truncated to maxDriftDetailDiffs
+// entries) as a suffix for a drift event message, further truncating it so
+// the message can't blow past the API server's event size limit.
+func eventDiffSuffix(diffJSON string) string {
+	if diffJSON == "" {
+		return ""
+	}
+	return fmt.Sprintf(" diffs=%s", truncatePatch([]byte(diffJSON)))
+}
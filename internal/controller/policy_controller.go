@@ -0,0 +1,365 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/record"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	tfdriftv1alpha1 "github.com/Bichong-Jin/tfdrift-operator/api/v1alpha1"
+	"github.com/Bichong-Jin/tfdrift-operator/internal/drift"
+	"github.com/Bichong-Jin/tfdrift-operator/internal/metrics"
+)
+
+const policyKind = "DriftPolicy"
+
+// PolicyReconciler generalizes drift detection to resource kinds that don't
+// have a hard-coded reconciler (DeploymentReconciler, ServiceReconciler): it
+// fetches every object matched by a DriftPolicy's TargetRef as unstructured
+// data via Dynamic, projects the fields named in FieldPaths, hashes the
+// result with drift.HashJSON, and compares that against ExpectedHashFrom.
+//
+// It deliberately does NOT replace DeploymentReconciler/ServiceReconciler for
+// Deployments/Services, and a DriftPolicy targeting those kinds runs
+// alongside them rather than instead of them. Two reasons: it is
+// detection-only (remediation needs a typed expected spec -
+// expectedDeploymentSpec, expectedServiceSpec - to build a strategic merge
+// patch, and there's no generic equivalent for an arbitrary Kind), and it
+// has no access to the richer per-kind signal those reconcilers already
+// compute (HPA-aware spec.replicas ignoring, per-field diffs via
+// drift.Diff). Deployment/Service users should keep using the typed
+// reconcilers; PolicyReconciler is for everything else.
+type PolicyReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+
+	// Dynamic fetches policy targets that have no registered Go type.
+	// SetupWithManager builds one from the manager's rest.Config when left
+	// nil, so it only needs to be set explicitly in tests.
+	Dynamic dynamic.Interface
+
+	// Verifier, when set, requires ExpectedHashFrom to carry a detached
+	// signature ("<hash>.<base64-signature>") bound to this DriftPolicy's own
+	// namespace/name, the same way DeploymentReconciler/ServiceReconciler
+	// require a signed AnnExpectedHash. Unlike those reconcilers the baseline
+	// isn't bound to a single target's identity - one policy's Selector can
+	// match many targets sharing it - so the signature is bound to the policy
+	// that declared the baseline instead.
+	Verifier *drift.Verifier
+}
+
+// +kubebuilder:rbac:groups=tfdrift.jin.dev,resources=driftpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=tfdrift.jin.dev,resources=driftpolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+func (r *PolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("driftpolicy", req.NamespacedName)
+
+	start := time.Now()
+	defer func() {
+		metrics.ReconcileDuration.WithLabelValues(policyKind).Observe(time.Since(start).Seconds())
+	}()
+
+	var policy tfdriftv1alpha1.DriftPolicy
+	if err := r.Get(ctx, req.NamespacedName, &policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	pollInterval := defaultPollInterval
+	if policy.Spec.PollInterval != nil {
+		pollInterval = policy.Spec.PollInterval.Duration
+	}
+
+	expectedHash, err := r.resolveExpectedHash(ctx, &policy)
+	if err != nil {
+		log.Error(err, "failed to resolve expected hash")
+		r.Recorder.Eventf(&policy, corev1.EventTypeWarning, "TerraformExpectedHashUnresolved", "%s", err)
+		return ctrl.Result{RequeueAfter: pollInterval}, nil
+	}
+
+	if r.Verifier != nil {
+		verifiedHash, err := r.Verifier.Verify(expectedHash, policy.Namespace+"/"+policy.Name)
+		if err != nil {
+			r.Recorder.Eventf(&policy, corev1.EventTypeWarning, "TerraformExpectedHashUntrusted",
+				"ExpectedHashFrom rejected: %s", err)
+			log.Info("rejecting untrusted expected hash", "error", err.Error())
+			return ctrl.Result{RequeueAfter: pollInterval}, nil
+		}
+		expectedHash = verifiedHash
+	}
+
+	targets, err := r.listTargets(ctx, &policy)
+	if err != nil {
+		log.Error(err, "failed to list policy targets")
+		r.Recorder.Eventf(&policy, corev1.EventTypeWarning, "TerraformPolicyTargetsUnresolved", "%s", err)
+		return ctrl.Result{RequeueAfter: pollInterval}, nil
+	}
+
+	var drifted []string
+	for _, target := range targets {
+		isDrifted, err := r.reconcileTarget(ctx, log, &policy, target, expectedHash)
+		if err != nil {
+			log.Error(err, "failed to reconcile policy target", "target", target.GetName())
+			continue
+		}
+		if isDrifted {
+			drifted = append(drifted, target.GetNamespace()+"/"+target.GetName())
+		}
+	}
+
+	now := metav1.Now()
+	orig := policy.DeepCopy()
+	policy.Status.ObservedTargets = len(targets)
+	policy.Status.DriftedTargets = drifted
+	policy.Status.LastCheckedAt = &now
+	if err := r.Status().Patch(ctx, &policy, client.MergeFrom(orig)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: pollInterval}, nil
+}
+
+// reconcileTarget projects policy's FieldPaths out of target, hashes the
+// projection, and compares it against expectedHash, recording the result on
+// target as drift.AnnLiveHash/AnnLastCheckedAt/AnnDrifted/AnnDriftedAt - the
+// same annotations DeploymentReconciler/ServiceReconciler use. Unlike those
+// reconcilers, it does not set drift.AnnExpectedHash on the target itself:
+// the baseline lives on the DriftPolicy (ExpectedHashFrom), not the target,
+// since one policy's Selector can match many targets sharing one baseline.
+func (r *PolicyReconciler) reconcileTarget(ctx context.Context, log logr.Logger, policy *tfdriftv1alpha1.DriftPolicy, target *unstructured.Unstructured, expectedHash string) (bool, error) {
+	projection := projectFields(target.Object, policy.Spec.FieldPaths, policy.Spec.IgnorePaths)
+
+	liveHash, err := drift.HashJSON(projection)
+	if err != nil {
+		return false, err
+	}
+
+	drifted := liveHash != expectedHash
+	namespace, name := target.GetNamespace(), target.GetName()
+
+	metrics.ResourceDrifted.WithLabelValues(policy.Spec.TargetRef.Kind, namespace, name).Set(boolToFloat(drifted))
+	metrics.LastCheckTimestamp.WithLabelValues(policy.Spec.TargetRef.Kind, namespace, name).SetToCurrentTime()
+
+	annotations := target.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	annotations[drift.AnnLiveHash] = liveHash
+	annotations[drift.AnnLastCheckedAt] = now
+	if drifted {
+		if annotations[drift.AnnDrifted] != "true" {
+			annotations[drift.AnnDriftedAt] = now
+		}
+		annotations[drift.AnnDrifted] = "true"
+	} else {
+		annotations[drift.AnnDrifted] = "false"
+	}
+	target.SetAnnotations(annotations)
+
+	gvr, err := r.targetGVR(policy.Spec.TargetRef)
+	if err != nil {
+		return drifted, err
+	}
+	if _, err := r.Dynamic.Resource(gvr).Namespace(policy.Namespace).Patch(ctx, target.GetName(), types.MergePatchType,
+		mergePatchAnnotations(annotations), metav1.PatchOptions{}); err != nil {
+		return drifted, err
+	}
+
+	if drifted {
+		metrics.DriftDetectedTotal.WithLabelValues(policy.Spec.TargetRef.Kind).Inc()
+		r.Recorder.Eventf(policy, corev1.EventTypeWarning, "TerraformDriftDetected",
+			"%s %s/%s drifted: expectedHash=%s liveHash=%s", policy.Spec.TargetRef.Kind, namespace, name, expectedHash, liveHash)
+		log.Info("drift detected", "target", name, "expected", expectedHash, "live", liveHash)
+	}
+
+	return drifted, nil
+}
+
+// listTargets resolves a DriftPolicy's TargetRef to the unstructured objects
+// it matches, either a single named object or everything Selector matches.
+func (r *PolicyReconciler) listTargets(ctx context.Context, policy *tfdriftv1alpha1.DriftPolicy) ([]*unstructured.Unstructured, error) {
+	gvr, err := r.targetGVR(policy.Spec.TargetRef)
+	if err != nil {
+		return nil, err
+	}
+	res := r.Dynamic.Resource(gvr).Namespace(policy.Namespace)
+
+	if policy.Spec.TargetRef.Name != "" {
+		obj, err := res.Get(ctx, policy.Spec.TargetRef.Name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []*unstructured.Unstructured{obj}, nil
+	}
+
+	listOpts := metav1.ListOptions{}
+	if policy.Spec.TargetRef.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(policy.Spec.TargetRef.Selector)
+		if err != nil {
+			return nil, err
+		}
+		listOpts.LabelSelector = selector.String()
+	}
+
+	list, err := res.List(ctx, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		targets = append(targets, &list.Items[i])
+	}
+	return targets, nil
+}
+
+// resolveExpectedHash reads the baseline hash a policy's targets are
+// compared against, from whichever source ExpectedHashFrom names.
+func (r *PolicyReconciler) resolveExpectedHash(ctx context.Context, policy *tfdriftv1alpha1.DriftPolicy) (string, error) {
+	src := policy.Spec.ExpectedHashFrom
+	switch src.Type {
+	case tfdriftv1alpha1.ExpectedHashInline:
+		return src.Inline, nil
+	case tfdriftv1alpha1.ExpectedHashConfigMap:
+		if src.ConfigMapRef == nil {
+			return "", fmt.Errorf("expectedHashFrom.type is ConfigMap but configMapRef is unset")
+		}
+		var cm corev1.ConfigMap
+		if err := r.Get(ctx, types.NamespacedName{Namespace: policy.Namespace, Name: src.ConfigMapRef.Name}, &cm); err != nil {
+			return "", err
+		}
+		key := expectedHashKey(src.Key)
+		hash, ok := cm.Data[key]
+		if !ok {
+			return "", fmt.Errorf("configMap %s has no key %q", src.ConfigMapRef.Name, key)
+		}
+		return hash, nil
+	case tfdriftv1alpha1.ExpectedHashSecret:
+		if src.SecretRef == nil {
+			return "", fmt.Errorf("expectedHashFrom.type is Secret but secretRef is unset")
+		}
+		var secret corev1.Secret
+		if err := r.Get(ctx, types.NamespacedName{Namespace: policy.Namespace, Name: src.SecretRef.Name}, &secret); err != nil {
+			return "", err
+		}
+		key := expectedHashKey(src.Key)
+		hash, ok := secret.Data[key]
+		if !ok {
+			return "", fmt.Errorf("secret %s has no key %q", src.SecretRef.Name, key)
+		}
+		return string(hash), nil
+	default:
+		return "", fmt.Errorf("unknown expectedHashFrom.type %q", src.Type)
+	}
+}
+
+func expectedHashKey(key string) string {
+	if key == "" {
+		return "hash"
+	}
+	return key
+}
+
+// targetGVR resolves a DriftTargetRef to the GroupVersionResource Dynamic
+// needs, via the manager's RESTMapper rather than guessing the plural form -
+// a hand-rolled pluralizer gets real Kinds wrong (e.g. "Endpoints" is already
+// plural) and would defeat the point of supporting arbitrary Kinds without
+// code changes.
+func (r *PolicyReconciler) targetGVR(ref tfdriftv1alpha1.DriftTargetRef) (schema.GroupVersionResource, error) {
+	if ref.Version == "" || ref.Kind == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("targetRef.version and targetRef.kind are required")
+	}
+	mapping, err := r.RESTMapper().RESTMapping(schema.GroupKind{Group: ref.Group, Kind: ref.Kind}, ref.Version)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("resolving targetRef %s/%s %s: %w", ref.Group, ref.Version, ref.Kind, err)
+	}
+	return mapping.Resource, nil
+}
+
+// projectFields walks obj and returns a map containing only the dot-separated
+// paths named in fieldPaths, skipping any path named in ignorePaths. Paths
+// are plain field-name navigation (no array indexing, no JSONPath
+// expressions), matching the rest of this repo's MVP-scoped field handling.
+func projectFields(obj map[string]any, fieldPaths, ignorePaths []string) map[string]any {
+	out := map[string]any{}
+	for _, path := range fieldPaths {
+		if pathIgnored(ignorePaths, path) {
+			continue
+		}
+		value, found, err := unstructured.NestedFieldNoCopy(obj, strings.Split(path, ".")...)
+		if err != nil || !found {
+			continue
+		}
+		out[path] = value
+	}
+	return out
+}
+
+func pathIgnored(ignorePaths []string, path string) bool {
+	for _, ignored := range ignorePaths {
+		if ignored == path {
+			return true
+		}
+	}
+	return false
+}
+
+func mergePatchAnnotations(annotations map[string]string) []byte {
+	patch := map[string]any{"metadata": map[string]any{"annotations": annotations}}
+	b, _ := json.Marshal(patch)
+	return b
+}
+
+func (r *PolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("tfdrift-operator")
+	if r.Dynamic == nil {
+		dyn, err := dynamic.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			return fmt.Errorf("building dynamic client: %w", err)
+		}
+		r.Dynamic = dyn
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tfdriftv1alpha1.DriftPolicy{}).
+		Complete(r)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Bichong-Jin/tfdrift-operator/internal/drift"
+)
+
+// RemediationMode selects whether reconcilers are allowed to write back to
+// drifted resources.
+type RemediationMode string
+
+const (
+	// RemediationOff never patches drifted resources; drift is only reported.
+	RemediationOff RemediationMode = "off"
+	// RemediationDryRun computes the patch that would be applied and records
+	// it on a TerraformDriftWouldRemediate event, but never applies it.
+	RemediationDryRun RemediationMode = "dry-run"
+	// RemediationEnforce applies the computed patch to revert drifted fields.
+	RemediationEnforce RemediationMode = "enforce"
+)
+
+// ParseRemediationMode parses the --remediation-mode flag value.
+func ParseRemediationMode(s string) (RemediationMode, error) {
+	switch RemediationMode(s) {
+	case "", RemediationOff:
+		return RemediationOff, nil
+	case RemediationDryRun:
+		return RemediationDryRun, nil
+	case RemediationEnforce:
+		return RemediationEnforce, nil
+	default:
+		return "", fmt.Errorf("unknown remediation mode %q (want off, dry-run or enforce)", s)
+	}
+}
+
+const (
+	remediationBaseBackoff = 30 * time.Second
+	remediationMaxBackoff  = 30 * time.Minute
+
+	// maxPatchEventBytes caps how much of a computed patch is embedded in an
+	// event message; events have a size limit enforced by the API server.
+	maxPatchEventBytes = 1024
+)
+
+// truncatePatch renders a patch for an event message, truncating it so it
+// can't blow past the API server's event size limit.
+func truncatePatch(patch []byte) string {
+	if len(patch) <= maxPatchEventBytes {
+		return string(patch)
+	}
+	return string(patch[:maxPatchEventBytes]) + "...(truncated)"
+}
+
+// remediationBackoff returns the exponential-backoff cooldown for the given
+// number of prior remediation attempts on an object, capped at
+// remediationMaxBackoff.
+func remediationBackoff(attempts int) time.Duration {
+	d := remediationBaseBackoff
+	for i := 0; i < attempts && d < remediationMaxBackoff; i++ {
+		d *= 2
+	}
+	if d > remediationMaxBackoff {
+		d = remediationMaxBackoff
+	}
+	return d
+}
+
+// fieldIgnored reports whether path (e.g. "spec.replicas") is present in ignoreFields.
+func fieldIgnored(ignoreFields []string, path string) bool {
+	for _, f := range ignoreFields {
+		if f == path {
+			return true
+		}
+	}
+	return false
+}
+
+// remediationAttempts parses the AnnRemediationAttempts annotation, defaulting to 0.
+func remediationAttempts(annotations map[string]string) int {
+	n, err := strconv.Atoi(annotations[drift.AnnRemediationAttempts])
+	if err != nil {
+		return 0
+	}
+	return n
+}
@@ -18,12 +18,16 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
 	"k8s.io/client-go/tools/record"
 
@@ -31,19 +35,50 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/Bichong-Jin/tfdrift-operator/internal/drift"
+	"github.com/Bichong-Jin/tfdrift-operator/internal/metrics"
 )
 
+const serviceKind = "Service"
+
 type ServiceReconciler struct {
 	client.Client
 	Log      logr.Logger
 	Recorder record.EventRecorder
+
+	// RemediationMode gates whether drifted Services are patched back to
+	// their expected spec. Defaults to RemediationOff.
+	RemediationMode RemediationMode
+	// IgnoreFields lists dotted spec paths ("spec.selector", "spec.ports",
+	// "spec.type") to leave untouched during remediation.
+	IgnoreFields []string
+
+	// Verifier checks that AnnExpectedHash is a valid detached signature
+	// over the baseline hash before trusting it, so editing the annotation
+	// directly can't hide drift. Populated from --trusted-keys-secret when
+	// set; nil disables signature verification and AnnExpectedHash is
+	// compared as a plain hash, as before.
+	Verifier *drift.Verifier
+}
+
+// expectedServiceSpec is the subset of a Service spec an AnnExpectedSpecRef
+// ConfigMap may declare (JSON-encoded under the "spec" key).
+type expectedServiceSpec struct {
+	Type     corev1.ServiceType   `json:"type,omitempty"`
+	Selector map[string]string    `json:"selector,omitempty"`
+	Ports    []corev1.ServicePort `json:"ports,omitempty"`
 }
 
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.Log.WithValues("service", req.NamespacedName)
 
+	start := time.Now()
+	defer func() {
+		metrics.ReconcileDuration.WithLabelValues(serviceKind).Observe(time.Since(start).Seconds())
+	}()
+
 	var svc corev1.Service
 	if err := r.Get(ctx, req.NamespacedName, &svc); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -66,6 +101,20 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		})
 	}
 
+	if r.Verifier != nil {
+		verifiedHash, err := r.Verifier.Verify(expected, svc.Namespace+"/"+svc.Name)
+		if err != nil {
+			r.Recorder.Eventf(&svc, corev1.EventTypeWarning, "TerraformExpectedHashUntrusted",
+				"Expected hash annotation rejected: %s", err)
+			log.Info("rejecting untrusted expected hash", "error", err.Error())
+			return r.patchAnnotations(ctx, &svc, map[string]string{
+				drift.AnnExpectedHashValid: "false",
+				drift.AnnLastCheckedAt:     time.Now().UTC().Format(time.RFC3339),
+			})
+		}
+		expected = verifiedHash
+	}
+
 	liveHash, err := drift.HashService(&svc)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -76,6 +125,9 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		drift.AnnLiveHash:      liveHash,
 		drift.AnnLastCheckedAt: now,
 	}
+	if r.Verifier != nil {
+		patch[drift.AnnExpectedHashValid] = "true"
+	}
 
 	drifted := (liveHash != expected)
 	if drifted {
@@ -84,14 +136,174 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			patch[drift.AnnDriftedAt] = now
 		}
 
+		diffJSON := ""
+		if diffs := r.driftDiffs(ctx, &svc); len(diffs) > 0 {
+			if blob, err := drift.TopFieldDiffsJSON(diffs, maxDriftDetailDiffs); err == nil {
+				patch[drift.AnnDriftDetails] = blob
+				diffJSON = blob
+			}
+		}
+
+		metrics.DriftDetectedTotal.WithLabelValues(serviceKind).Inc()
 		r.Recorder.Eventf(&svc, corev1.EventTypeWarning, "TerraformDriftDetected",
-			"Service drift detected: expectedHash=%s liveHash=%s", expected, liveHash)
+			"Service drift detected: expectedHash=%s liveHash=%s%s", expected, liveHash, eventDiffSuffix(diffJSON))
 		log.Info("drift detected", "expected", expected, "live", liveHash)
 	} else {
 		patch[drift.AnnDrifted] = "false"
 	}
 
-	return r.patchAnnotations(ctx, &svc, patch)
+	metrics.ResourceDrifted.WithLabelValues(serviceKind, svc.Namespace, svc.Name).Set(boolToFloat(drifted))
+	metrics.LastCheckTimestamp.WithLabelValues(serviceKind, svc.Namespace, svc.Name).SetToCurrentTime()
+
+	if _, err := r.patchAnnotations(ctx, &svc, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+	if !drifted {
+		return ctrl.Result{}, nil
+	}
+
+	return r.maybeRemediate(ctx, log, &svc)
+}
+
+// driftDiffs computes field-level diffs between the live Service and the
+// "expected spec" ConfigMap named by AnnExpectedSpecRef, if one is set. It
+// returns nil (not an error) when no expected-spec-ref is configured, since
+// AnnExpectedHash alone doesn't carry enough information to diff fields.
+func (r *ServiceReconciler) driftDiffs(ctx context.Context, svc *corev1.Service) []drift.FieldDiff {
+	specRefName := svc.Annotations[drift.AnnExpectedSpecRef]
+	if specRefName == "" {
+		return nil
+	}
+
+	var cm corev1.ConfigMap
+	if err := r.Get(ctx, types.NamespacedName{Namespace: svc.Namespace, Name: specRefName}, &cm); err != nil {
+		return nil
+	}
+
+	var expected expectedServiceSpec
+	if err := json.Unmarshal([]byte(cm.Data["spec"]), &expected); err != nil {
+		return nil
+	}
+
+	liveFP := drift.FingerprintService(svc)
+	expectedFP := liveFP
+	if expected.Type != "" {
+		expectedFP.Type = expected.Type
+	}
+	if expected.Selector != nil {
+		expectedFP.Selector = expected.Selector
+	}
+	if expected.Ports != nil {
+		expectedFP.Ports = drift.FingerprintService(&corev1.Service{
+			Spec: corev1.ServiceSpec{Ports: expected.Ports},
+		}).Ports
+	}
+
+	return drift.Diff(expectedFP, liveFP)
+}
+
+// maybeRemediate reverts a drifted Service's fingerprinted fields back to
+// the "expected spec" ConfigMap named by AnnExpectedSpecRef, when the
+// tfdrift.jin.dev/remediate=true label is set and RemediationMode allows it.
+func (r *ServiceReconciler) maybeRemediate(ctx context.Context, log logr.Logger, svc *corev1.Service) (ctrl.Result, error) {
+	if r.RemediationMode == RemediationOff || svc.Labels[drift.LabelRemediate] != "true" {
+		return ctrl.Result{}, nil
+	}
+
+	if until, ok := svc.Annotations[drift.AnnRemediationCooldownUntil]; ok {
+		if t, err := time.Parse(time.RFC3339, until); err == nil {
+			if remaining := time.Until(t); remaining > 0 {
+				return ctrl.Result{RequeueAfter: remaining}, nil
+			}
+		}
+	}
+
+	specRefName := svc.Annotations[drift.AnnExpectedSpecRef]
+	if specRefName == "" {
+		log.Info("remediation enabled but no expected-spec-ref annotation set")
+		return ctrl.Result{}, nil
+	}
+
+	var cm corev1.ConfigMap
+	if err := r.Get(ctx, types.NamespacedName{Namespace: svc.Namespace, Name: specRefName}, &cm); err != nil {
+		log.Error(err, "failed to get expected-spec ConfigMap", "configMap", specRefName)
+		return ctrl.Result{}, nil
+	}
+
+	var expected expectedServiceSpec
+	if err := json.Unmarshal([]byte(cm.Data["spec"]), &expected); err != nil {
+		log.Error(err, "failed to parse expected spec", "configMap", specRefName)
+		return ctrl.Result{}, nil
+	}
+
+	patchBytes, err := computeServicePatch(svc, expected, r.IgnoreFields)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if patchBytes == nil {
+		return ctrl.Result{}, nil
+	}
+
+	attempts := remediationAttempts(svc.Annotations)
+	backoff := remediationBackoff(attempts)
+
+	switch r.RemediationMode {
+	case RemediationEnforce:
+		if err := r.Patch(ctx, svc, client.RawPatch(types.StrategicMergePatchType, patchBytes)); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(svc, corev1.EventTypeNormal, "TerraformDriftRemediated",
+			"Reverted drifted fields to expected spec: %s", truncatePatch(patchBytes))
+		log.Info("remediated drift", "patch", string(patchBytes))
+	case RemediationDryRun:
+		r.Recorder.Eventf(svc, corev1.EventTypeNormal, "TerraformDriftWouldRemediate",
+			"Would revert drifted fields (dry-run): %s", truncatePatch(patchBytes))
+		log.Info("would remediate drift (dry-run)", "patch", string(patchBytes))
+	}
+
+	if _, err := r.patchAnnotations(ctx, svc, map[string]string{
+		drift.AnnRemediationCooldownUntil: time.Now().UTC().Add(backoff).Format(time.RFC3339),
+		drift.AnnRemediationAttempts:      strconv.Itoa(attempts + 1),
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: backoff}, nil
+}
+
+// computeServicePatch builds the strategic merge patch that reverts svc's
+// fingerprinted fields to expected, skipping any field named in ignoreFields.
+// It returns a nil patch when there is nothing to revert.
+func computeServicePatch(svc *corev1.Service, expected expectedServiceSpec, ignoreFields []string) ([]byte, error) {
+	originalJSON, err := json.Marshal(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := svc.DeepCopy()
+	if expected.Type != "" && !fieldIgnored(ignoreFields, "spec.type") {
+		desired.Spec.Type = expected.Type
+	}
+	if expected.Selector != nil && !fieldIgnored(ignoreFields, "spec.selector") {
+		desired.Spec.Selector = expected.Selector
+	}
+	if expected.Ports != nil && !fieldIgnored(ignoreFields, "spec.ports") {
+		desired.Spec.Ports = expected.Ports
+	}
+
+	desiredJSON, err := json.Marshal(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	patchBytes, err := strategicpatch.CreateTwoWayMergePatch(originalJSON, desiredJSON, corev1.Service{})
+	if err != nil {
+		return nil, err
+	}
+	if string(patchBytes) == "{}" {
+		return nil, nil
+	}
+	return patchBytes, nil
 }
 
 func (r *ServiceReconciler) patchAnnotations(ctx context.Context, svc *corev1.Service, kv map[string]string) (ctrl.Result, error) {
@@ -116,6 +328,3 @@ func (r *ServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&corev1.Service{}).
 		Complete(r)
 }
-
-
-
@@ -0,0 +1,220 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/client-go/tools/record"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	tfdriftv1alpha1 "github.com/Bichong-Jin/tfdrift-operator/api/v1alpha1"
+	"github.com/Bichong-Jin/tfdrift-operator/internal/drift"
+	"github.com/Bichong-Jin/tfdrift-operator/internal/tfstate"
+)
+
+const defaultPollInterval = 5 * time.Minute
+
+// TerraformStateReconciler reads a TerraformStateSource's terraform.tfstate
+// document and projects each managed kubernetes_deployment(_v1)/
+// kubernetes_service(_v1) resource onto drift.AnnExpectedHash, so operators
+// no longer have to compute and set that annotation by hand.
+type TerraformStateReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tfdrift.jin.dev,resources=terraformstatesources,verbs=get;list;watch
+// +kubebuilder:rbac:groups=tfdrift.jin.dev,resources=terraformstatesources/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+func (r *TerraformStateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("terraformstatesource", req.NamespacedName)
+
+	var src tfdriftv1alpha1.TerraformStateSource
+	if err := r.Get(ctx, req.NamespacedName, &src); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	pollInterval := defaultPollInterval
+	if src.Spec.PollInterval != nil {
+		pollInterval = src.Spec.PollInterval.Duration
+	}
+
+	raw, err := tfstate.Fetch(ctx, r.Client, src.Namespace, src.Spec.SourceRef)
+	if err != nil {
+		log.Error(err, "failed to fetch terraform state")
+		r.Recorder.Eventf(&src, corev1.EventTypeWarning, "TerraformStateFetchFailed", "%s", err)
+		return ctrl.Result{RequeueAfter: pollInterval}, nil
+	}
+
+	state, err := tfstate.Parse(raw)
+	if err != nil {
+		log.Error(err, "failed to parse terraform state")
+		r.Recorder.Eventf(&src, corev1.EventTypeWarning, "TerraformStateParseFailed", "%s", err)
+		return ctrl.Result{RequeueAfter: pollInterval}, nil
+	}
+
+	observed := 0
+	for _, res := range state.Resources {
+		switch {
+		case tfstate.IsDeployment(res.Type):
+			for _, inst := range res.Instances {
+				if r.applyDeploymentHash(ctx, log, inst.Attributes, state) {
+					observed++
+				}
+			}
+		case tfstate.IsService(res.Type):
+			for _, inst := range res.Instances {
+				if r.applyServiceHash(ctx, log, inst.Attributes, state) {
+					observed++
+				}
+			}
+		}
+	}
+
+	now := metav1.Now()
+	orig := src.DeepCopy()
+	src.Status.LastSyncedAt = &now
+	src.Status.TerraformVersion = state.TerraformVersion
+	src.Status.Serial = state.Serial
+	src.Status.ObservedResources = observed
+	if err := r.Status().Patch(ctx, &src, client.MergeFrom(orig)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: pollInterval}, nil
+}
+
+func (r *TerraformStateReconciler) applyDeploymentHash(ctx context.Context, log logr.Logger, attrs map[string]any, state *tfstate.State) bool {
+	nn, ok := tfstate.TargetNamespacedName(attrs)
+	if !ok {
+		return false
+	}
+
+	var dep appsv1.Deployment
+	if err := r.Get(ctx, nn, &dep); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "failed to get Deployment", "deployment", nn)
+		}
+		return false
+	}
+
+	fp, err := tfstate.ProjectDeployment(attrs)
+	if err != nil {
+		log.Error(err, "failed to project Deployment attributes", "deployment", nn)
+		return false
+	}
+
+	hash, err := drift.HashDeploymentFingerprint(fp)
+	if err != nil {
+		log.Error(err, "failed to hash projected Deployment", "deployment", nn)
+		return false
+	}
+
+	r.setExpectedHash(ctx, &dep, hash, state)
+	return true
+}
+
+func (r *TerraformStateReconciler) applyServiceHash(ctx context.Context, log logr.Logger, attrs map[string]any, state *tfstate.State) bool {
+	nn, ok := tfstate.TargetNamespacedName(attrs)
+	if !ok {
+		return false
+	}
+
+	var svc corev1.Service
+	if err := r.Get(ctx, nn, &svc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "failed to get Service", "service", nn)
+		}
+		return false
+	}
+
+	fp, err := tfstate.ProjectService(attrs)
+	if err != nil {
+		log.Error(err, "failed to project Service attributes", "service", nn)
+		return false
+	}
+
+	hash, err := drift.HashServiceFingerprint(fp)
+	if err != nil {
+		log.Error(err, "failed to hash projected Service", "service", nn)
+		return false
+	}
+
+	r.setExpectedHash(ctx, &svc, hash, state)
+	return true
+}
+
+// setExpectedHash patches drift.AnnExpectedHash (plus the terraform
+// version/serial trace annotations) onto obj and emits an event recording
+// which state snapshot produced the baseline.
+//
+// The hash is written unsigned - see the --trusted-keys-secret
+// incompatibility noted on drift.AnnTerraformVersion.
+func (r *TerraformStateReconciler) setExpectedHash(ctx context.Context, obj client.Object, hash string, state *tfstate.State) {
+	orig, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if annotations[drift.AnnExpectedHash] == hash {
+		return
+	}
+	annotations[drift.AnnExpectedHash] = hash
+	annotations[drift.AnnTerraformVersion] = state.TerraformVersion
+	annotations[drift.AnnTerraformSerial] = strconv.FormatInt(state.Serial, 10)
+	obj.SetAnnotations(annotations)
+
+	if err := r.Patch(ctx, obj, client.MergeFrom(orig)); err != nil {
+		r.Log.Error(err, "failed to patch expected hash", "object", client.ObjectKeyFromObject(obj))
+		return
+	}
+
+	r.Recorder.Eventf(obj, corev1.EventTypeNormal, "TerraformExpectedHashUpdated",
+		"Expected hash updated from terraform state (version=%s serial=%d): %s",
+		state.TerraformVersion, state.Serial, hash)
+}
+
+func (r *TerraformStateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("tfdrift-operator")
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tfdriftv1alpha1.TerraformStateSource{}).
+		Complete(r)
+}
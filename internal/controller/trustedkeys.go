@@ -0,0 +1,51 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Bichong-Jin/tfdrift-operator/internal/drift"
+)
+
+// LoadTrustedKeysVerifier builds a drift.Verifier trusting every PEM-encoded
+// public key in the named Secret's data (one key per entry; key names are
+// unused and may be anything, e.g. "key1.pem"). It's how a --trusted-keys-secret
+// flag gets turned into DeploymentReconciler.Verifier/ServiceReconciler.Verifier.
+func LoadTrustedKeysVerifier(ctx context.Context, c client.Client, namespace, secretName string) (*drift.Verifier, error) {
+	var secret corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: secretName}, &secret); err != nil {
+		return nil, fmt.Errorf("get trusted keys secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	pemKeys := make([][]byte, 0, len(secret.Data))
+	for _, raw := range secret.Data {
+		pemKeys = append(pemKeys, raw)
+	}
+
+	verifier, err := drift.NewVerifier(pemKeys...)
+	if err != nil {
+		return nil, fmt.Errorf("trusted keys secret %s/%s: %w", namespace, secretName, err)
+	}
+	return verifier, nil
+}
@@ -3,9 +3,51 @@ package drift
 const (
 	LabelEnabled = "tfdrift.jin.dev/enabled"
 
+	// LabelRemediate opts a resource into auto-remediation. It only takes
+	// effect when the controller is run with --remediation-mode=dry-run or
+	// --remediation-mode=enforce; the label alone does nothing under the
+	// default "off" mode.
+	LabelRemediate = "tfdrift.jin.dev/remediate"
+
 	AnnExpectedHash  = "tfdrift.jin.dev/spec-hash"
 	AnnLiveHash      = "tfdrift.jin.dev/live-hash"
 	AnnDrifted       = "tfdrift.jin.dev/drifted"
 	AnnLastCheckedAt = "tfdrift.jin.dev/last-checked-at"
 	AnnDriftedAt     = "tfdrift.jin.dev/drifted-at"
+
+	// AnnTerraformVersion and AnnTerraformSerial record which terraform.tfstate
+	// snapshot last produced AnnExpectedHash, so operators can trace a baseline
+	// back to the state file (and `terraform apply`) that set it.
+	//
+	// TerraformStateReconciler always writes AnnExpectedHash as a bare,
+	// unsigned hash. That's incompatible with --trusted-keys-secret: once
+	// signature verification is on, reconcilers reject any AnnExpectedHash
+	// that isn't in signed "<hash>.<sig>" form, so tfstate-populated baselines
+	// are rejected as untrusted (AnnExpectedHashValid stays "false") on every
+	// reconcile. Don't enable both against the same objects until something
+	// signs the annotation the tfstate reconciler writes.
+	AnnTerraformVersion = "tfdrift.jin.dev/terraform-version"
+	AnnTerraformSerial  = "tfdrift.jin.dev/terraform-serial"
+
+	// AnnExpectedSpecRef names the ConfigMap (in the same namespace) that
+	// holds the "expected spec" auto-remediation reverts drifted fields back to.
+	AnnExpectedSpecRef = "tfdrift.jin.dev/expected-spec-ref"
+
+	// AnnRemediationCooldownUntil and AnnRemediationAttempts back the
+	// exponential-backoff cooldown that keeps auto-remediation from fighting
+	// other controllers (e.g. an HPA repeatedly resetting spec.replicas).
+	AnnRemediationCooldownUntil = "tfdrift.jin.dev/remediation-cooldown-until"
+	AnnRemediationAttempts      = "tfdrift.jin.dev/remediation-attempts"
+
+	// AnnDriftDetails holds a JSON-encoded []FieldDiff (truncated to the
+	// top few) describing which fields drifted, so operators don't have to
+	// diff specs by hand to find out what changed.
+	AnnDriftDetails = "tfdrift.jin.dev/drift-details"
+
+	// AnnExpectedHashValid records whether AnnExpectedHash passed signature
+	// verification on the most recent reconcile. It's only set when the
+	// controller is run with --trusted-keys-secret; reconcilers set it to
+	// "false" (and skip drift comparison) instead of trusting an unsigned or
+	// invalidly-signed annotation.
+	AnnExpectedHashValid = "tfdrift.jin.dev/expected-hash-valid"
 )
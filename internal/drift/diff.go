@@ -0,0 +1,229 @@
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FieldDiff is one field that differs between an expected and a live
+// fingerprint, e.g. {Path: "template.containers[nginx].image", ...}.
+type FieldDiff struct {
+	Path     string `json:"path"`
+	Expected any    `json:"expected,omitempty"`
+	Live     any    `json:"live,omitempty"`
+}
+
+// Diff walks expected and live - which must be the same fingerprint type,
+// e.g. two DeploymentFingerprint or two ServiceFingerprint values - and
+// returns every field that differs between them, sorted by path. It exists
+// so reconcilers can say *what* drifted, not just *that* something drifted.
+func Diff(expected, live any) []FieldDiff {
+	var out []FieldDiff
+	diffValues("", reflect.ValueOf(expected), reflect.ValueOf(live), &out)
+	sort.Slice(out, func(i, j int) bool { return out[i].Path < out[j].Path })
+	return out
+}
+
+func diffValues(path string, ev, lv reflect.Value, out *[]FieldDiff) {
+	ev, evNil := indirect(ev)
+	lv, lvNil := indirect(lv)
+	if evNil && lvNil {
+		return
+	}
+	if evNil != lvNil {
+		*out = append(*out, FieldDiff{Path: path, Expected: interfaceOrNil(ev, evNil), Live: interfaceOrNil(lv, lvNil)})
+		return
+	}
+
+	switch ev.Kind() {
+	case reflect.Struct:
+		t := ev.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" { // unexported
+				continue
+			}
+			diffValues(joinPath(path, jsonFieldName(f)), ev.Field(i), lv.Field(i), out)
+		}
+	case reflect.Map:
+		diffMap(path, ev, lv, out)
+	case reflect.Slice, reflect.Array:
+		diffSlice(path, ev, lv, out)
+	default:
+		if !reflect.DeepEqual(ev.Interface(), lv.Interface()) {
+			*out = append(*out, FieldDiff{Path: path, Expected: ev.Interface(), Live: lv.Interface()})
+		}
+	}
+}
+
+// indirect dereferences pointers, reporting whether the value is absent (a nil pointer).
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	if v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}, true
+		}
+		return v.Elem(), false
+	}
+	return v, false
+}
+
+func interfaceOrNil(v reflect.Value, isNil bool) any {
+	if isNil || !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
+
+func diffMap(path string, ev, lv reflect.Value, out *[]FieldDiff) {
+	// Keyed by k.String() for dedup/sort, but indexed into the maps via the
+	// original reflect.Value - the map's key type may be a named type (e.g.
+	// corev1.ResourceName), and reflect.ValueOf(k.String()) isn't assignable
+	// to that type, which panics on MapIndex.
+	keys := map[string]reflect.Value{}
+	for _, k := range ev.MapKeys() {
+		keys[k.String()] = k
+	}
+	for _, k := range lv.MapKeys() {
+		keys[k.String()] = k
+	}
+
+	names := make(map[string]bool, len(keys))
+	for k := range keys {
+		names[k] = true
+	}
+	for _, k := range sortedKeys(names) {
+		kv := keys[k]
+		evv := ev.MapIndex(kv)
+		lvv := lv.MapIndex(kv)
+		childPath := fmt.Sprintf("%s[%s]", path, k)
+		switch {
+		case !evv.IsValid():
+			*out = append(*out, FieldDiff{Path: childPath, Live: lvv.Interface()})
+		case !lvv.IsValid():
+			*out = append(*out, FieldDiff{Path: childPath, Expected: evv.Interface()})
+		case !reflect.DeepEqual(evv.Interface(), lvv.Interface()):
+			*out = append(*out, FieldDiff{Path: childPath, Expected: evv.Interface(), Live: lvv.Interface()})
+		}
+	}
+}
+
+// diffSlice diffs two slices. Elements of a struct type with a Name field
+// (every *Fingerprint element type in this package has one) are matched up
+// by name rather than index, so reordering containers/ports doesn't read as
+// a wholesale replacement. When names repeat within either slice (e.g.
+// several unnamed ports), elements are matched up by index instead.
+func diffSlice(path string, ev, lv reflect.Value, out *[]FieldDiff) {
+	elemType := ev.Type().Elem()
+	if elemType.Kind() == reflect.Struct {
+		if _, ok := elemType.FieldByName("Name"); ok && uniqueNames(ev) && uniqueNames(lv) {
+			diffSliceByName(path, ev, lv, out)
+			return
+		}
+	}
+
+	n := ev.Len()
+	if lv.Len() > n {
+		n = lv.Len()
+	}
+	for i := 0; i < n; i++ {
+		childPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= ev.Len():
+			*out = append(*out, FieldDiff{Path: childPath, Live: lv.Index(i).Interface()})
+		case i >= lv.Len():
+			*out = append(*out, FieldDiff{Path: childPath, Expected: ev.Index(i).Interface()})
+		default:
+			diffValues(childPath, ev.Index(i), lv.Index(i), out)
+		}
+	}
+}
+
+// uniqueNames reports whether every element of v has a distinct Name field.
+func uniqueNames(v reflect.Value) bool {
+	seen := make(map[string]bool, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		n := v.Index(i).FieldByName("Name").String()
+		if seen[n] {
+			return false
+		}
+		seen[n] = true
+	}
+	return true
+}
+
+func diffSliceByName(path string, ev, lv reflect.Value, out *[]FieldDiff) {
+	byName := func(v reflect.Value) map[string]reflect.Value {
+		m := make(map[string]reflect.Value, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i)
+			m[item.FieldByName("Name").String()] = item
+		}
+		return m
+	}
+	evByName, lvByName := byName(ev), byName(lv)
+
+	names := map[string]bool{}
+	for n := range evByName {
+		names[n] = true
+	}
+	for n := range lvByName {
+		names[n] = true
+	}
+
+	for _, n := range sortedKeys(names) {
+		childPath := fmt.Sprintf("%s[%s]", path, n)
+		evi, evok := evByName[n]
+		lvi, lvok := lvByName[n]
+		switch {
+		case !evok:
+			*out = append(*out, FieldDiff{Path: childPath, Live: lvi.Interface()})
+		case !lvok:
+			*out = append(*out, FieldDiff{Path: childPath, Expected: evi.Interface()})
+		default:
+			diffValues(childPath, evi, lvi, out)
+		}
+	}
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func jsonFieldName(f reflect.StructField) string {
+	tag := f.Tag.Get("json")
+	if tag == "" || tag == "-" {
+		return f.Name
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name
+	}
+	return f.Name
+}
+
+// TopFieldDiffsJSON JSON-encodes at most n of diffs (already sorted by Diff),
+// for use in the AnnDriftDetails annotation and in event messages.
+func TopFieldDiffsJSON(diffs []FieldDiff, n int) (string, error) {
+	if len(diffs) > n {
+		diffs = diffs[:n]
+	}
+	b, err := json.Marshal(diffs)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
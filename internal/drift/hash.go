@@ -48,13 +48,38 @@ type ContainerPortFingerprint struct {
 }
 
 func HashDeployment(dep *appsv1.Deployment) (string, error) {
+	return HashDeploymentFingerprint(FingerprintDeployment(dep))
+}
+
+// FingerprintDeployment builds the canonicalized DeploymentFingerprint for
+// dep. It's exported (alongside HashDeploymentFingerprint) so callers that
+// need the structured fingerprint itself - e.g. Diff, or a fingerprint
+// projected from a terraform.tfstate resource - don't have to re-derive it
+// from a Deployment by hand.
+func FingerprintDeployment(dep *appsv1.Deployment) DeploymentFingerprint {
 	fp := DeploymentFingerprint{
 		Replicas: dep.Spec.Replicas,
 		Strategy: dep.Spec.Strategy,
 		Template: fingerprintPodTemplate(dep.Spec.Template),
 	}
+	canonicalizeDeploymentFingerprint(&fp)
+	return fp
+}
 
-	// Canonicalize (stable ordering)
+// HashDeploymentFingerprint canonicalizes and hashes a DeploymentFingerprint
+// built by a caller other than HashDeployment (e.g. one projected from a
+// terraform.tfstate resource), so both sides of a drift comparison go
+// through the exact same canonicalization.
+func HashDeploymentFingerprint(fp DeploymentFingerprint) (string, error) {
+	canonicalizeDeploymentFingerprint(&fp)
+	return HashJSON(fp)
+}
+
+// canonicalizeDeploymentFingerprint imposes a stable ordering on fp so that
+// semantically identical Deployments always hash (and diff) the same way
+// regardless of the order the API server or terraform happened to return
+// containers/env/ports in. It is idempotent.
+func canonicalizeDeploymentFingerprint(fp *DeploymentFingerprint) {
 	canonicalizeMap(fp.Template.Labels)
 	canonicalizeMap(fp.Template.Annotations)
 	sort.Slice(fp.Template.Containers, func(i, j int) bool {
@@ -73,8 +98,6 @@ func HashDeployment(dep *appsv1.Deployment) (string, error) {
 			return pa < pb
 		})
 	}
-
-	return hashJSON(fp)
 }
 
 func fingerprintPodTemplate(t corev1.PodTemplateSpec) PodTemplateFingerprint {
@@ -128,6 +151,11 @@ type ServicePortFingerprint struct {
 }
 
 func HashService(svc *corev1.Service) (string, error) {
+	return HashServiceFingerprint(FingerprintService(svc))
+}
+
+// FingerprintService builds the canonicalized ServiceFingerprint for svc.
+func FingerprintService(svc *corev1.Service) ServiceFingerprint {
 	fp := ServiceFingerprint{
 		Type:     svc.Spec.Type,
 		Selector: copyMap(svc.Spec.Selector),
@@ -143,7 +171,21 @@ func HashService(svc *corev1.Service) (string, error) {
 			NodePort:   p.NodePort,
 		})
 	}
+	canonicalizeServiceFingerprint(&fp)
+	return fp
+}
 
+// HashServiceFingerprint canonicalizes and hashes a ServiceFingerprint built
+// by a caller other than HashService (e.g. one projected from a
+// terraform.tfstate resource), so both sides of a drift comparison go
+// through the exact same canonicalization.
+func HashServiceFingerprint(fp ServiceFingerprint) (string, error) {
+	canonicalizeServiceFingerprint(&fp)
+	return HashJSON(fp)
+}
+
+// canonicalizeServiceFingerprint imposes a stable ordering on fp. It is idempotent.
+func canonicalizeServiceFingerprint(fp *ServiceFingerprint) {
 	canonicalizeMap(fp.Selector)
 	sort.Slice(fp.Ports, func(i, j int) bool {
 		if fp.Ports[i].Port == fp.Ports[j].Port {
@@ -151,13 +193,15 @@ func HashService(svc *corev1.Service) (string, error) {
 		}
 		return fp.Ports[i].Port < fp.Ports[j].Port
 	})
-
-	return hashJSON(fp)
 }
 
 // ---- helpers ----
 
-func hashJSON(v any) (string, error) {
+// HashJSON canonicalizes v to JSON and returns its SHA-256 hex digest. It's
+// exported so callers projecting a canonicalized document from a source
+// other than a DeploymentFingerprint/ServiceFingerprint - e.g. PolicyReconciler's
+// arbitrary-field-path projections - hash it the exact same way.
+func HashJSON(v any) (string, error) {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return "", err
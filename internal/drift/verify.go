@@ -0,0 +1,179 @@
+package drift
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// signatureSeparator joins a fingerprint hash and its detached signature in
+// a signed AnnExpectedHash annotation: "<hash><signatureSeparator><base64-signature>".
+const signatureSeparator = "."
+
+// Verifier checks that a signed AnnExpectedHash annotation was produced by
+// one of a trusted set of public keys, so editing the annotation directly -
+// without also forging a valid signature - doesn't silently hide drift.
+// Supports Ed25519 and ECDSA P-256 keys.
+type Verifier struct {
+	keys []crypto.PublicKey
+}
+
+// NewVerifier builds a Verifier trusting the given PEM-encoded
+// SubjectPublicKeyInfo blocks, e.g. one per data entry of the Secret named
+// by --trusted-keys-secret. It returns an error if any block fails to parse
+// or isn't an Ed25519/ECDSA P-256 key.
+func NewVerifier(pemKeys ...[]byte) (*Verifier, error) {
+	if len(pemKeys) == 0 {
+		return nil, errors.New("no trusted keys provided")
+	}
+	v := &Verifier{keys: make([]crypto.PublicKey, 0, len(pemKeys))}
+	for _, raw := range pemKeys {
+		key, err := ParsePublicKey(raw)
+		if err != nil {
+			return nil, err
+		}
+		v.keys = append(v.keys, key)
+	}
+	return v, nil
+}
+
+// Verify parses annotation as "<hash>.<base64-signature>" and returns hash
+// if the signature validates under any trusted key for subject. subject
+// binds the signature to the specific object it applies to (e.g.
+// "namespace/name") so a validly-signed annotation can't be copied from one
+// object onto another - it must be included exactly as passed to Sign.
+// Verify returns an error for an unsigned annotation (no separator),
+// malformed base64, or a signature that no trusted key validates for subject.
+func (v *Verifier) Verify(annotation, subject string) (string, error) {
+	hash, sig, ok := splitSignedAnnotation(annotation)
+	if !ok {
+		return "", errors.New("annotation is not signed, want \"<hash>.<base64-signature>\"")
+	}
+
+	sigBytes, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		return "", fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	message := signedMessage(subject, hash)
+	for _, key := range v.keys {
+		if verifyWithKey(key, message, sigBytes) {
+			return hash, nil
+		}
+	}
+	return "", errors.New("signature not valid under any trusted key")
+}
+
+func splitSignedAnnotation(annotation string) (hash, sig string, ok bool) {
+	idx := strings.LastIndex(annotation, signatureSeparator)
+	if idx <= 0 || idx == len(annotation)-1 {
+		return "", "", false
+	}
+	return annotation[:idx], annotation[idx+1:], true
+}
+
+// signedMessage is what actually gets signed/verified: the hash alone isn't
+// enough, since that would let a validly-signed annotation be replayed from
+// one object onto another unrelated one that happens to hash the same way
+// some attacker wants it to.
+func signedMessage(subject, hash string) []byte {
+	return []byte(subject + ":" + hash)
+}
+
+// Sign signs hash for subject (e.g. "namespace/name") with key, returning
+// the "<hash>.<base64-signature>" value to store in AnnExpectedHash. It's
+// the inverse of Verifier.Verify, used by cmd/tfdriftctl's "sign" subcommand.
+func Sign(key crypto.Signer, subject, hash string) (string, error) {
+	sig, err := signWithKey(key, signedMessage(subject, hash))
+	if err != nil {
+		return "", err
+	}
+	return hash + signatureSeparator + base64.StdEncoding.EncodeToString(sig), nil
+}
+
+func signWithKey(key crypto.Signer, message []byte) ([]byte, error) {
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		return ed25519.Sign(k, message), nil
+	case *ecdsa.PrivateKey:
+		return ecdsa.SignASN1(rand.Reader, k, digest(message))
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T (want Ed25519 or ECDSA P-256)", key)
+	}
+}
+
+func verifyWithKey(key crypto.PublicKey, message, sig []byte) bool {
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, message, sig)
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, digest(message), sig)
+	default:
+		return false
+	}
+}
+
+// digest hashes message for ECDSA signing/verification. Ed25519 signs
+// messages directly and never calls this.
+func digest(message []byte) []byte {
+	sum := sha256.Sum256(message)
+	return sum[:]
+}
+
+// ParsePublicKey parses a PEM-encoded SubjectPublicKeyInfo block, returning
+// an error unless the key is Ed25519 or ECDSA P-256.
+func ParsePublicKey(raw []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("invalid PEM block")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key: %w", err)
+	}
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		return k, nil
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("unsupported ECDSA curve %s (want P-256)", k.Curve.Params().Name)
+		}
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T (want Ed25519 or ECDSA P-256)", key)
+	}
+}
+
+// ParsePrivateKey parses a PEM-encoded PKCS#8 private key, returning an
+// error unless the key is Ed25519 or ECDSA P-256. Used by cmd/tfdriftctl's
+// "sign" subcommand.
+func ParsePrivateKey(raw []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("invalid PEM block")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		return k, nil
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("unsupported ECDSA curve %s (want P-256)", k.Curve.Params().Name)
+		}
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T (want Ed25519 or ECDSA P-256)", key)
+	}
+}
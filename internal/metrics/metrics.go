@@ -0,0 +1,46 @@
+// Package metrics defines the Prometheus metrics tfdrift-operator exposes on
+// the controller-runtime metrics server, so drift can be alerted on instead
+// of only discovered by reading annotations/events.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// ResourceDrifted is 1 while a resource is drifted, 0 otherwise.
+	ResourceDrifted = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tfdrift_resource_drifted",
+		Help: "1 if the resource currently differs from its expected (terraform) spec, 0 otherwise.",
+	}, []string{"kind", "namespace", "name"})
+
+	// DriftDetectedTotal counts every reconcile that found drift, by kind.
+	DriftDetectedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "tfdrift_drift_detected_total",
+		Help: "Total number of times drift was detected, by resource kind.",
+	}, []string{"kind"})
+
+	// ReconcileDuration tracks how long a reconcile of each kind takes.
+	ReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tfdrift_reconcile_duration_seconds",
+		Help:    "Time spent in a single drift-detection reconcile, by resource kind.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"kind"})
+
+	// LastCheckTimestamp is the unix timestamp of the last drift check for a resource.
+	LastCheckTimestamp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tfdrift_last_check_timestamp_seconds",
+		Help: "Unix timestamp of the last drift check for the resource.",
+	}, []string{"kind", "namespace", "name"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		ResourceDrifted,
+		DriftDetectedTotal,
+		ReconcileDuration,
+		LastCheckTimestamp,
+	)
+}
@@ -0,0 +1,103 @@
+package tfstate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	tfdriftv1alpha1 "github.com/Bichong-Jin/tfdrift-operator/api/v1alpha1"
+)
+
+// defaultStateKey is the key looked up in a ConfigMap/Secret source when
+// TerraformStateSourceRef.Key is not set.
+const defaultStateKey = "terraform.tfstate"
+
+// Fetch retrieves the raw terraform.tfstate JSON document described by ref.
+//
+// Only the ConfigMap, Secret and HTTP source types exist. S3/GCS/Azure
+// backends are left as follow-up work (they'd need their respective SDKs
+// vendored in) and aren't part of TerraformStateSourceType - see the doc
+// comment on TerraformStateSourceRef.
+func Fetch(ctx context.Context, c client.Client, namespace string, ref tfdriftv1alpha1.TerraformStateSourceRef) ([]byte, error) {
+	switch ref.Type {
+	case tfdriftv1alpha1.TerraformStateSourceConfigMap:
+		return fetchConfigMap(ctx, c, namespace, ref)
+	case tfdriftv1alpha1.TerraformStateSourceSecret:
+		return fetchSecret(ctx, c, namespace, ref)
+	case tfdriftv1alpha1.TerraformStateSourceHTTP:
+		return fetchHTTP(ctx, ref)
+	default:
+		return nil, fmt.Errorf("tfstate: unknown source type %q", ref.Type)
+	}
+}
+
+func fetchConfigMap(ctx context.Context, c client.Client, namespace string, ref tfdriftv1alpha1.TerraformStateSourceRef) ([]byte, error) {
+	if ref.ConfigMapRef == nil {
+		return nil, fmt.Errorf("tfstate: sourceRef.type is ConfigMap but configMapRef is unset")
+	}
+	var cm corev1.ConfigMap
+	key := types.NamespacedName{Namespace: namespace, Name: ref.ConfigMapRef.Name}
+	if err := c.Get(ctx, key, &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("tfstate: ConfigMap %s not found: %w", key, err)
+		}
+		return nil, err
+	}
+
+	dataKey := defaultString(ref.Key, defaultStateKey)
+	if v, ok := cm.Data[dataKey]; ok {
+		return []byte(v), nil
+	}
+	if v, ok := cm.BinaryData[dataKey]; ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("tfstate: ConfigMap %s has no key %q", key, dataKey)
+}
+
+func fetchSecret(ctx context.Context, c client.Client, namespace string, ref tfdriftv1alpha1.TerraformStateSourceRef) ([]byte, error) {
+	if ref.SecretRef == nil {
+		return nil, fmt.Errorf("tfstate: sourceRef.type is Secret but secretRef is unset")
+	}
+	var secret corev1.Secret
+	key := types.NamespacedName{Namespace: namespace, Name: ref.SecretRef.Name}
+	if err := c.Get(ctx, key, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("tfstate: Secret %s not found: %w", key, err)
+		}
+		return nil, err
+	}
+
+	dataKey := defaultString(ref.Key, defaultStateKey)
+	v, ok := secret.Data[dataKey]
+	if !ok {
+		return nil, fmt.Errorf("tfstate: Secret %s has no key %q", key, dataKey)
+	}
+	return v, nil
+}
+
+func fetchHTTP(ctx context.Context, ref tfdriftv1alpha1.TerraformStateSourceRef) ([]byte, error) {
+	if ref.URL == "" {
+		return nil, fmt.Errorf("tfstate: sourceRef.type is HTTP but url is unset")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ref.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("tfstate: GET %s: unexpected status %s", ref.URL, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
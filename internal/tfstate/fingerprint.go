@@ -0,0 +1,242 @@
+package tfstate
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/Bichong-Jin/tfdrift-operator/internal/drift"
+)
+
+// TargetNamespacedName returns the namespace/name of the live object that a
+// kubernetes_deployment(_v1)/kubernetes_service(_v1) instance's attributes describe.
+// Namespace defaults to "default", matching the Kubernetes provider's own behavior.
+func TargetNamespacedName(attrs map[string]any) (types.NamespacedName, bool) {
+	meta := block(attrs, "metadata")
+	name := getString(meta, "name")
+	if name == "" {
+		return types.NamespacedName{}, false
+	}
+	namespace := getString(meta, "namespace")
+	if namespace == "" {
+		namespace = "default"
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, true
+}
+
+// ProjectDeployment projects a kubernetes_deployment(_v1) instance's attributes
+// onto a drift.DeploymentFingerprint.
+//
+// Note: the Strategy field has no equivalent in the kubernetes_deployment_v1
+// resource schema, so it is left at its zero value here; fingerprints built
+// from terraform state never claim to cover it.
+func ProjectDeployment(attrs map[string]any) (drift.DeploymentFingerprint, error) {
+	spec := block(attrs, "spec")
+	if spec == nil {
+		return drift.DeploymentFingerprint{}, fmt.Errorf("tfstate: resource has no spec block")
+	}
+
+	tmpl := block(spec, "template")
+	meta := block(tmpl, "metadata")
+	podSpec := block(tmpl, "spec")
+
+	containerBlocks := blocks(podSpec, "container")
+	containers := make([]drift.ContainerFingerprint, 0, len(containerBlocks))
+	for _, c := range containerBlocks {
+		containers = append(containers, projectContainer(c))
+	}
+
+	return drift.DeploymentFingerprint{
+		Replicas: getInt32Ptr(spec, "replicas"),
+		Template: drift.PodTemplateFingerprint{
+			Labels:      getStringMap(meta, "labels"),
+			Annotations: getStringMap(meta, "annotations"),
+			Containers:  containers,
+		},
+	}, nil
+}
+
+func projectContainer(c map[string]any) drift.ContainerFingerprint {
+	envBlocks := blocks(c, "env")
+	env := make([]drift.EnvVarFingerprint, 0, len(envBlocks))
+	for _, e := range envBlocks {
+		env = append(env, drift.EnvVarFingerprint{Name: getString(e, "name"), Value: getString(e, "value")})
+	}
+
+	portBlocks := blocks(c, "port")
+	ports := make([]drift.ContainerPortFingerprint, 0, len(portBlocks))
+	for _, p := range portBlocks {
+		ports = append(ports, drift.ContainerPortFingerprint{
+			Name:          getString(p, "name"),
+			ContainerPort: int32(getFloat(p, "container_port")),
+			Protocol:      corev1.Protocol(defaultString(getString(p, "protocol"), string(corev1.ProtocolTCP))),
+		})
+	}
+
+	return drift.ContainerFingerprint{
+		Name:      getString(c, "name"),
+		Image:     getString(c, "image"),
+		Env:       env,
+		Ports:     ports,
+		Resources: projectResources(c),
+	}
+}
+
+func projectResources(c map[string]any) corev1.ResourceRequirements {
+	res := block(c, "resources")
+	if res == nil {
+		return corev1.ResourceRequirements{}
+	}
+	return corev1.ResourceRequirements{
+		Limits:   quantityMap(block(res, "limits")),
+		Requests: quantityMap(block(res, "requests")),
+	}
+}
+
+func quantityMap(m map[string]any) corev1.ResourceList {
+	if len(m) == 0 {
+		return nil
+	}
+	out := make(corev1.ResourceList, len(m))
+	for k, v := range m {
+		s, ok := v.(string)
+		if !ok || s == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(s)
+		if err != nil {
+			continue
+		}
+		out[corev1.ResourceName(k)] = q
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// ProjectService projects a kubernetes_service(_v1) instance's attributes onto
+// a drift.ServiceFingerprint.
+func ProjectService(attrs map[string]any) (drift.ServiceFingerprint, error) {
+	spec := block(attrs, "spec")
+	if spec == nil {
+		return drift.ServiceFingerprint{}, fmt.Errorf("tfstate: resource has no spec block")
+	}
+
+	portBlocks := blocks(spec, "port")
+	ports := make([]drift.ServicePortFingerprint, 0, len(portBlocks))
+	for _, p := range portBlocks {
+		ports = append(ports, drift.ServicePortFingerprint{
+			Name:       getString(p, "name"),
+			Protocol:   corev1.Protocol(defaultString(getString(p, "protocol"), string(corev1.ProtocolTCP))),
+			Port:       int32(getFloat(p, "port")),
+			TargetPort: targetPortString(p["target_port"]),
+			NodePort:   int32(getFloat(p, "node_port")),
+		})
+	}
+
+	return drift.ServiceFingerprint{
+		Type:     corev1.ServiceType(defaultString(getString(spec, "type"), string(corev1.ServiceTypeClusterIP))),
+		Selector: getStringMap(spec, "selector"),
+		Ports:    ports,
+	}, nil
+}
+
+func targetPortString(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return fmt.Sprintf("%d", int64(t))
+	default:
+		return ""
+	}
+}
+
+// ---- generic HCL-block-in-JSON helpers ----
+//
+// terraform.tfstate represents nested blocks (e.g. `spec { ... }`) as a
+// one-element []any of map[string]any, and repeatable blocks (e.g.
+// `container { ... }`) as a multi-element []any of map[string]any.
+
+func block(m map[string]any, key string) map[string]any {
+	bs := blocks(m, key)
+	if len(bs) == 0 {
+		return nil
+	}
+	return bs[0]
+}
+
+func blocks(m map[string]any, key string) []map[string]any {
+	if m == nil {
+		return nil
+	}
+	raw, ok := m[key].([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]map[string]any, 0, len(raw))
+	for _, v := range raw {
+		if mv, ok := v.(map[string]any); ok {
+			out = append(out, mv)
+		}
+	}
+	return out
+}
+
+func getString(m map[string]any, key string) string {
+	if m == nil {
+		return ""
+	}
+	s, _ := m[key].(string)
+	return s
+}
+
+func getFloat(m map[string]any, key string) float64 {
+	if m == nil {
+		return 0
+	}
+	f, _ := m[key].(float64)
+	return f
+}
+
+func getInt32Ptr(m map[string]any, key string) *int32 {
+	if m == nil {
+		return nil
+	}
+	f, ok := m[key].(float64)
+	if !ok {
+		return nil
+	}
+	v := int32(f)
+	return &v
+}
+
+func getStringMap(m map[string]any, key string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	raw, ok := m[key].(map[string]any)
+	if !ok {
+		return nil
+	}
+	out := make(map[string]string, len(raw))
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			out[k] = s
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,58 @@
+// Package tfstate parses terraform.tfstate JSON documents and projects the
+// kubernetes_* resources they describe onto the fingerprint shapes used by
+// package drift, so an expected hash can be computed without a human having
+// to hand-compute it.
+package tfstate
+
+import "encoding/json"
+
+// Resource type names as they appear in a terraform.tfstate document.
+const (
+	ResourceTypeDeployment   = "kubernetes_deployment"
+	ResourceTypeDeploymentV1 = "kubernetes_deployment_v1"
+	ResourceTypeService      = "kubernetes_service"
+	ResourceTypeServiceV1    = "kubernetes_service_v1"
+)
+
+// State is the subset of the terraform.tfstate schema we care about.
+type State struct {
+	Version          int        `json:"version"`
+	TerraformVersion string     `json:"terraform_version"`
+	Serial           int64      `json:"serial"`
+	Lineage          string     `json:"lineage"`
+	Resources        []Resource `json:"resources"`
+}
+
+// Resource is one `resource` block tracked in state, e.g. a kubernetes_deployment_v1.
+type Resource struct {
+	Mode      string     `json:"mode"`
+	Type      string     `json:"type"`
+	Name      string     `json:"name"`
+	Provider  string     `json:"provider"`
+	Instances []Instance `json:"instances"`
+}
+
+// Instance is one resource instance (index_key set when the resource uses count/for_each).
+type Instance struct {
+	IndexKey   any            `json:"index_key,omitempty"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+// Parse decodes a terraform.tfstate JSON document.
+func Parse(data []byte) (*State, error) {
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// IsDeployment reports whether a resource type is a Deployment-shaped kubernetes resource.
+func IsDeployment(resourceType string) bool {
+	return resourceType == ResourceTypeDeployment || resourceType == ResourceTypeDeploymentV1
+}
+
+// IsService reports whether a resource type is a Service-shaped kubernetes resource.
+func IsService(resourceType string) bool {
+	return resourceType == ResourceTypeService || resourceType == ResourceTypeServiceV1
+}